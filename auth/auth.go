@@ -0,0 +1,14 @@
+package auth
+
+import "context"
+
+// NewAuthorizer returns an Authorizer for the given Config. This is the
+// entry point used by msgraph.ClientFactory and by callers of the
+// NewXxxClient constructors who wire up authentication themselves. It
+// delegates to NewChainedAuthorizer so that every authentication method
+// enabled on Config - including workload identity federation - is reachable
+// from here, rather than requiring callers to bypass this function and
+// construct a ChainedAuthorizer or FederatedAuthorizer by hand.
+func NewAuthorizer(ctx context.Context, config Config) (Authorizer, error) {
+	return NewChainedAuthorizer(config)
+}
@@ -0,0 +1,19 @@
+package auth
+
+import (
+	"context"
+	"time"
+)
+
+// Token represents an access token obtained from Microsoft Identity Platform,
+// together with its expiry.
+type Token struct {
+	AccessToken string
+	ExpiresOn   time.Time
+}
+
+// Authorizer obtains access tokens for authenticating requests to Microsoft
+// Graph.
+type Authorizer interface {
+	Token(ctx context.Context) (*Token, error)
+}
@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"time"
+)
+
+// azureCliAuthorizer authenticates by delegating to an access token already
+// cached by a signed-in Azure CLI session.
+type azureCliAuthorizer struct {
+	config Config
+}
+
+// newAzureCliAuthorizer returns an Authorizer that shells out to `az account
+// get-access-token`.
+func newAzureCliAuthorizer(config Config) Authorizer {
+	return &azureCliAuthorizer{config: config}
+}
+
+func (a *azureCliAuthorizer) Token(ctx context.Context) (*Token, error) {
+	args := []string{"account", "get-access-token", "--output", "json", "--resource", a.config.environment().GraphEndpoint()}
+	if a.config.TenantID != "" {
+		args = append(args, "--tenant", a.config.TenantID)
+	}
+
+	out, err := exec.CommandContext(ctx, "az", args...).Output()
+	if err != nil {
+		return nil, fmt.Errorf("running az account get-access-token: %v", err)
+	}
+
+	var result struct {
+		AccessToken string `json:"accessToken"`
+		ExpiresOn   string `json:"expiresOn"`
+	}
+	if err := json.Unmarshal(out, &result); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	expiresOn, err := time.ParseInLocation("2006-01-02 15:04:05.000000", result.ExpiresOn, time.Local)
+	if err != nil {
+		expiresOn = time.Now().Add(time.Hour)
+	}
+
+	return &Token{
+		AccessToken: result.AccessToken,
+		ExpiresOn:   expiresOn,
+	}, nil
+}
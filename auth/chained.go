@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// ChainedAuthorizer tries a sequence of Authorizers in turn, in the order
+// recommended for unattended/CI scenarios, and returns the token from the
+// first one that succeeds. Once an Authorizer in the chain has produced a
+// token it is pinned as the chain's sole authorizer, so that subsequent calls
+// skip straight past the probes that failed.
+type ChainedAuthorizer struct {
+	candidates []Authorizer
+	active     Authorizer
+}
+
+// NewChainedAuthorizer builds a ChainedAuthorizer from the authentication
+// methods enabled on config, probed in order: client secret/certificate,
+// workload identity federation, managed identity, then Azure CLI.
+func NewChainedAuthorizer(config Config) (*ChainedAuthorizer, error) {
+	config.Environment = config.environment()
+
+	var candidates []Authorizer
+
+	if config.EnableClientSecretAuth {
+		candidates = append(candidates, newClientSecretAuthorizer(config))
+	}
+	if config.EnableClientCertAuth {
+		candidates = append(candidates, newClientCertAuthorizer(config))
+	}
+	if config.EnableClientFederatedAuth {
+		candidates = append(candidates, newFederatedAuthorizer(config))
+	}
+	if config.EnableMsiAuth {
+		candidates = append(candidates, newMsiAuthorizer(config))
+	}
+	if config.EnableAzureCliToken {
+		candidates = append(candidates, newAzureCliAuthorizer(config))
+	}
+
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("auth: no authentication method enabled in Config")
+	}
+
+	return &ChainedAuthorizer{candidates: candidates}, nil
+}
+
+// Token returns a token from the first candidate Authorizer that succeeds,
+// then pins that Authorizer for subsequent calls.
+func (a *ChainedAuthorizer) Token(ctx context.Context) (*Token, error) {
+	if a.active != nil {
+		return a.active.Token(ctx)
+	}
+
+	var errs []string
+	for _, candidate := range a.candidates {
+		token, err := candidate.Token(ctx)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		a.active = candidate
+		return token, nil
+	}
+
+	return nil, fmt.Errorf("auth: no authorizer in chain could obtain a token: %s", strings.Join(errs, "; "))
+}
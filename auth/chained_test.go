@@ -0,0 +1,32 @@
+package auth_test
+
+import (
+	"testing"
+
+	"github.com/manicminer/hamilton/auth"
+	"github.com/manicminer/hamilton/environments"
+)
+
+func TestNewChainedAuthorizer(t *testing.T) {
+	config := auth.Config{
+		Environment:            environments.Global,
+		TenantID:               "11111111-1111-1111-1111-111111111111",
+		ClientID:               "22222222-2222-2222-2222-222222222222",
+		EnableClientSecretAuth: true,
+		ClientSecret:           "secret",
+	}
+
+	authorizer, err := auth.NewChainedAuthorizer(config)
+	if err != nil {
+		t.Fatalf("NewChainedAuthorizer(): %v", err)
+	}
+	if authorizer == nil {
+		t.Fatal("NewChainedAuthorizer(): authorizer was nil")
+	}
+}
+
+func TestNewChainedAuthorizer_NoMethodsEnabled(t *testing.T) {
+	if _, err := auth.NewChainedAuthorizer(auth.Config{}); err == nil {
+		t.Fatal("NewChainedAuthorizer(): expected an error when no authentication method is enabled")
+	}
+}
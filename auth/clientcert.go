@@ -0,0 +1,107 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"time"
+
+	"golang.org/x/crypto/pkcs12"
+)
+
+// clientCertAuthorizer authenticates by presenting a self-signed JWT client
+// assertion, signed with a client certificate, per
+// https://learn.microsoft.com/azure/active-directory/develop/certificate-credentials.
+type clientCertAuthorizer struct {
+	config Config
+}
+
+// newClientCertAuthorizer returns an Authorizer that authenticates using the
+// client certificate at config.ClientCertPath.
+func newClientCertAuthorizer(config Config) Authorizer {
+	return &clientCertAuthorizer{config: config}
+}
+
+func (a *clientCertAuthorizer) Token(ctx context.Context) (*Token, error) {
+	environment := a.config.environment()
+	tokenEndpoint := fmt.Sprintf("%s/%s/oauth2/v2.0/token", environment.LoginEndpoint(), a.config.TenantID)
+
+	assertion, err := a.assertion(tokenEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	body := url.Values{
+		"client_id":             {a.config.ClientID},
+		"scope":                 {environment.GraphEndpoint() + "/.default"},
+		"client_assertion_type": {clientAssertionType},
+		"client_assertion":      {assertion},
+		"grant_type":            {"client_credentials"},
+	}
+
+	return requestToken(ctx, tokenEndpoint, body)
+}
+
+// assertion builds and signs a JWT client assertion from config's client
+// certificate, with aud set to tokenEndpoint as Microsoft Identity Platform
+// requires.
+func (a *clientCertAuthorizer) assertion(tokenEndpoint string) (string, error) {
+	pfxData, err := ioutil.ReadFile(a.config.ClientCertPath)
+	if err != nil {
+		return "", fmt.Errorf("reading ClientCertPath: %v", err)
+	}
+
+	key, cert, err := pkcs12.Decode(pfxData, a.config.ClientCertPassword)
+	if err != nil {
+		return "", fmt.Errorf("pkcs12.Decode(): %v", err)
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return "", fmt.Errorf("auth: ClientCertPath must contain an RSA private key")
+	}
+
+	thumbprint := sha1.Sum(cert.Raw)
+
+	now := time.Now()
+	header := map[string]any{
+		"alg": "RS256",
+		"typ": "JWT",
+		"x5t": base64.RawURLEncoding.EncodeToString(thumbprint[:]),
+	}
+	claims := map[string]any{
+		"aud": tokenEndpoint,
+		"iss": a.config.ClientID,
+		"sub": a.config.ClientID,
+		"jti": fmt.Sprintf("%d", now.UnixNano()),
+		"nbf": now.Unix(),
+		"exp": now.Add(5 * time.Minute).Unix(),
+	}
+
+	headerJSON, err := json.Marshal(header)
+	if err != nil {
+		return "", fmt.Errorf("json.Marshal(): %v", err)
+	}
+	claimsJSON, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(headerJSON) + "." + base64.RawURLEncoding.EncodeToString(claimsJSON)
+
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, rsaKey, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("rsa.SignPKCS1v15(): %v", err)
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
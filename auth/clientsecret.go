@@ -0,0 +1,33 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// clientSecretAuthorizer authenticates using a confidential client secret,
+// via the OAuth2 client credentials grant.
+type clientSecretAuthorizer struct {
+	config Config
+}
+
+// newClientSecretAuthorizer returns an Authorizer that authenticates using
+// config.ClientSecret.
+func newClientSecretAuthorizer(config Config) Authorizer {
+	return &clientSecretAuthorizer{config: config}
+}
+
+func (a *clientSecretAuthorizer) Token(ctx context.Context) (*Token, error) {
+	environment := a.config.environment()
+	tokenEndpoint := fmt.Sprintf("%s/%s/oauth2/v2.0/token", environment.LoginEndpoint(), a.config.TenantID)
+
+	body := url.Values{
+		"client_id":     {a.config.ClientID},
+		"client_secret": {a.config.ClientSecret},
+		"scope":         {environment.GraphEndpoint() + "/.default"},
+		"grant_type":    {"client_credentials"},
+	}
+
+	return requestToken(ctx, tokenEndpoint, body)
+}
@@ -1,6 +1,10 @@
 package auth
 
-import "github.com/manicminer/hamilton/environments"
+import (
+	"context"
+
+	"github.com/manicminer/hamilton/environments"
+)
 
 type TokenVersion int
 
@@ -46,4 +50,34 @@ type Config struct {
 
 	// Specifies the password to authenticate with using client secret authentication
 	ClientSecret string
+
+	// Enables authentication using workload identity federation (OIDC), exchanging a
+	// federated client assertion for a Microsoft Graph access token.
+	EnableClientFederatedAuth bool
+
+	// Specifies a federated client assertion (JWT) to present at the token endpoint.
+	// Ignored if FederatedAssertionProvider or FederatedAssertionFile is set.
+	FederatedAssertion string
+
+	// Specifies a path to a file containing a federated client assertion (JWT), read
+	// fresh on every token request. This is the mechanism used by GitHub Actions
+	// (AZURE_FEDERATED_TOKEN_FILE) and AKS pod workload identity. Ignored if
+	// FederatedAssertionProvider is set.
+	FederatedAssertionFile string
+
+	// FederatedAssertionProvider supplies a federated client assertion (JWT) at
+	// request time, for callers that mint their own assertion (e.g. from a GitHub
+	// Actions OIDC endpoint). Takes precedence over FederatedAssertion and
+	// FederatedAssertionFile when set.
+	FederatedAssertionProvider func(ctx context.Context) (string, error)
+}
+
+// environment returns c.Environment, defaulting to environments.Global when
+// it is unset, so that a zero-value Config doesn't silently produce
+// malformed endpoints and scopes built from empty strings.
+func (c Config) environment() environments.Environment {
+	if c.Environment == (environments.Environment{}) {
+		return environments.Global
+	}
+	return c.Environment
 }
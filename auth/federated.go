@@ -0,0 +1,78 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"strings"
+)
+
+// clientAssertionType is the value Microsoft Identity Platform expects for
+// client_assertion_type when exchanging a federated credential (OIDC JWT) for
+// an access token.
+const clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+// federatedAuthorizer exchanges a workload identity federation assertion for
+// a Microsoft Graph access token at the Microsoft Identity Platform v2 token
+// endpoint, using client_assertion_type=jwt-bearer.
+type federatedAuthorizer struct {
+	config Config
+}
+
+// newFederatedAuthorizer returns an Authorizer that performs workload
+// identity federation, per the auth methods enabled on config.
+func newFederatedAuthorizer(config Config) Authorizer {
+	return &federatedAuthorizer{config: config}
+}
+
+// NewFederatedAuthorizer returns an Authorizer that exchanges a workload
+// identity federation assertion for a Microsoft Graph access token. Returns
+// an error if config does not enable federated authentication.
+func NewFederatedAuthorizer(ctx context.Context, config Config) (Authorizer, error) {
+	if !config.EnableClientFederatedAuth {
+		return nil, fmt.Errorf("auth: EnableClientFederatedAuth is not set in Config")
+	}
+	return newFederatedAuthorizer(config), nil
+}
+
+// assertion resolves the federated client assertion to present at the token
+// endpoint, preferring an explicit FederatedAssertionProvider, then
+// FederatedAssertionFile, then the static FederatedAssertion.
+func (a *federatedAuthorizer) assertion(ctx context.Context) (string, error) {
+	if a.config.FederatedAssertionProvider != nil {
+		return a.config.FederatedAssertionProvider(ctx)
+	}
+	if a.config.FederatedAssertionFile != "" {
+		contents, err := ioutil.ReadFile(a.config.FederatedAssertionFile)
+		if err != nil {
+			return "", fmt.Errorf("reading FederatedAssertionFile: %v", err)
+		}
+		return strings.TrimSpace(string(contents)), nil
+	}
+	if a.config.FederatedAssertion != "" {
+		return a.config.FederatedAssertion, nil
+	}
+	return "", fmt.Errorf("auth: no federated assertion available, set FederatedAssertion, FederatedAssertionFile or FederatedAssertionProvider")
+}
+
+// Token exchanges the federated assertion for an access token.
+func (a *federatedAuthorizer) Token(ctx context.Context) (*Token, error) {
+	assertion, err := a.assertion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	environment := a.config.environment()
+	tokenEndpoint := fmt.Sprintf("%s/%s/oauth2/v2.0/token", environment.LoginEndpoint(), a.config.TenantID)
+
+	body := url.Values{
+		"client_id":             {a.config.ClientID},
+		"scope":                 {environment.GraphEndpoint() + "/.default"},
+		"client_assertion_type": {clientAssertionType},
+		"client_assertion":      {assertion},
+		"grant_type":            {"client_credentials"},
+	}
+
+	return requestToken(ctx, tokenEndpoint, body)
+}
@@ -0,0 +1,81 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// msiEndpointDefault is the Azure Instance Metadata Service endpoint used
+// when config.MsiEndpoint is not set.
+const msiEndpointDefault = "http://169.254.169.254/metadata/identity/oauth2/token"
+
+// msiAuthorizer authenticates using Azure Managed Service Identity.
+type msiAuthorizer struct {
+	config Config
+}
+
+// newMsiAuthorizer returns an Authorizer that authenticates using a managed
+// identity, querying config.MsiEndpoint (or the instance metadata service by
+// default).
+func newMsiAuthorizer(config Config) Authorizer {
+	return &msiAuthorizer{config: config}
+}
+
+func (a *msiAuthorizer) Token(ctx context.Context) (*Token, error) {
+	endpoint := a.config.MsiEndpoint
+	if endpoint == "" {
+		endpoint = msiEndpointDefault
+	}
+
+	params := url.Values{
+		"api-version": {"2018-02-01"},
+		"resource":    {a.config.environment().GraphEndpoint()},
+	}
+	if a.config.ClientID != "" {
+		params.Set("client_id", a.config.ClientID)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint+"?"+params.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("http.NewRequestWithContext(): %v", err)
+	}
+	req.Header.Set("Metadata", "true")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("querying MSI endpoint: %v", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d from MSI endpoint: %s", resp.StatusCode, string(respBody))
+	}
+
+	var tokenResponse struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   string `json:"expires_in"`
+	}
+	if err := json.Unmarshal(respBody, &tokenResponse); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	expiresIn, err := time.ParseDuration(tokenResponse.ExpiresIn + "s")
+	if err != nil {
+		expiresIn = time.Hour
+	}
+
+	return &Token{
+		AccessToken: tokenResponse.AccessToken,
+		ExpiresOn:   time.Now().Add(expiresIn),
+	}, nil
+}
@@ -0,0 +1,146 @@
+package environments
+
+import "sync"
+
+// APIEndpoint identifies the set of service hosts for a national cloud,
+// keyed off a single base identifier (e.g. "public", "usgovernmentl4").
+type APIEndpoint struct {
+	name                      string
+	graphEndpoint             string
+	loginEndpoint             string
+	resourceManagerEndpoint   string
+	serviceManagementEndpoint string
+}
+
+// Name returns the identifier this APIEndpoint was registered under.
+func (e APIEndpoint) Name() string { return e.name }
+
+// GraphEndpoint returns the Microsoft Graph host for this endpoint.
+func (e APIEndpoint) GraphEndpoint() string { return e.graphEndpoint }
+
+// LoginEndpoint returns the Microsoft Entra (Azure AD) login host for this
+// endpoint.
+func (e APIEndpoint) LoginEndpoint() string { return e.loginEndpoint }
+
+// ResourceManagerEndpoint returns the Azure Resource Manager host for this
+// endpoint.
+func (e APIEndpoint) ResourceManagerEndpoint() string { return e.resourceManagerEndpoint }
+
+// ServiceManagementEndpoint returns the Azure Service Management (classic)
+// host for this endpoint.
+func (e APIEndpoint) ServiceManagementEndpoint() string { return e.serviceManagementEndpoint }
+
+// Audience returns the OAuth2 resource/audience to request a token for when
+// authenticating against this endpoint's Microsoft Graph host.
+func (e APIEndpoint) Audience() string { return e.graphEndpoint + "/" }
+
+// NewAPIEndpoint returns an APIEndpoint with the given identifier and service
+// hosts, for registering a sovereign or private cloud with
+// RegisterEnvironment.
+func NewAPIEndpoint(name, graphEndpoint, loginEndpoint, resourceManagerEndpoint, serviceManagementEndpoint string) APIEndpoint {
+	return APIEndpoint{
+		name:                      name,
+		graphEndpoint:             graphEndpoint,
+		loginEndpoint:             loginEndpoint,
+		resourceManagerEndpoint:   resourceManagerEndpoint,
+		serviceManagementEndpoint: serviceManagementEndpoint,
+	}
+}
+
+var (
+	// EndpointPublic is the global Azure public cloud.
+	EndpointPublic = APIEndpoint{
+		name:                      "public",
+		graphEndpoint:             "https://graph.microsoft.com",
+		loginEndpoint:             "https://login.microsoftonline.com",
+		resourceManagerEndpoint:   "https://management.azure.com",
+		serviceManagementEndpoint: "https://management.core.windows.net",
+	}
+
+	// EndpointUSGovernmentL4 is the Azure US Government (L4) cloud.
+	EndpointUSGovernmentL4 = APIEndpoint{
+		name:                      "usgovernmentl4",
+		graphEndpoint:             "https://graph.microsoft.us",
+		loginEndpoint:             "https://login.microsoftonline.us",
+		resourceManagerEndpoint:   "https://management.usgovcloudapi.net",
+		serviceManagementEndpoint: "https://management.core.usgovcloudapi.net",
+	}
+
+	// EndpointUSGovernmentL5 is the Azure US Government DoD (L5) cloud.
+	EndpointUSGovernmentL5 = APIEndpoint{
+		name:                      "usgovernmentl5",
+		graphEndpoint:             "https://dod-graph.microsoft.us",
+		loginEndpoint:             "https://login.microsoftonline.us",
+		resourceManagerEndpoint:   "https://management.usgovcloudapi.net",
+		serviceManagementEndpoint: "https://management.core.usgovcloudapi.net",
+	}
+
+	// EndpointChina is the Azure China (21Vianet) cloud.
+	EndpointChina = APIEndpoint{
+		name:                      "china",
+		graphEndpoint:             "https://microsoftgraph.chinacloudapi.cn",
+		loginEndpoint:             "https://login.chinacloudapi.cn",
+		resourceManagerEndpoint:   "https://management.chinacloudapi.cn",
+		serviceManagementEndpoint: "https://management.core.chinacloudapi.cn",
+	}
+
+	// EndpointGermany is the Azure Germany cloud.
+	EndpointGermany = APIEndpoint{
+		name:                      "germany",
+		graphEndpoint:             "https://graph.microsoft.de",
+		loginEndpoint:             "https://login.microsoftonline.de",
+		resourceManagerEndpoint:   "https://management.microsoftazure.de",
+		serviceManagementEndpoint: "https://management.core.cloudapi.de",
+	}
+
+	registryMu sync.RWMutex
+	registry   = map[string]APIEndpoint{
+		EndpointPublic.name:         EndpointPublic,
+		EndpointUSGovernmentL4.name: EndpointUSGovernmentL4,
+		EndpointUSGovernmentL5.name: EndpointUSGovernmentL5,
+		EndpointChina.name:          EndpointChina,
+		EndpointGermany.name:        EndpointGermany,
+	}
+)
+
+// RegisterEnvironment adds or overrides the APIEndpoint registered under
+// endpoint.Name(), so that sovereign or private clouds can be supported
+// without forking this package.
+func RegisterEnvironment(endpoint APIEndpoint) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry[endpoint.name] = endpoint
+}
+
+// LookupEndpoint returns the APIEndpoint registered under name, if any.
+func LookupEndpoint(name string) (APIEndpoint, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	endpoint, ok := registry[name]
+	return endpoint, ok
+}
+
+// Environment represents an Azure national cloud environment: the set of
+// service endpoints and token audience a Config should use. It is an alias
+// for APIEndpoint, rather than a distinct wrapper type, so that Global,
+// China and friends are themselves APIEndpoints and can be registered,
+// looked up or compared against anything RegisterEnvironment/LookupEndpoint
+// hands back.
+type Environment = APIEndpoint
+
+var (
+	// Global is the global Azure public cloud.
+	Global = EndpointPublic
+
+	// USGovernmentL4 is the Azure US Government (L4) cloud.
+	USGovernmentL4 = EndpointUSGovernmentL4
+
+	// USGovernmentL5 is the Azure US Government DoD (L5) cloud.
+	USGovernmentL5 = EndpointUSGovernmentL5
+
+	// China is the Azure China (21Vianet) cloud.
+	China = EndpointChina
+
+	// Germany is the Azure Germany cloud.
+	Germany = EndpointGermany
+)
@@ -0,0 +1,76 @@
+package environments_test
+
+import (
+	"testing"
+
+	"github.com/manicminer/hamilton/environments"
+)
+
+func TestEnvironments(t *testing.T) {
+	cases := []struct {
+		name          string
+		environment   environments.Environment
+		loginEndpoint string
+		graphEndpoint string
+		audience      string
+	}{
+		{
+			name:          "Global",
+			environment:   environments.Global,
+			loginEndpoint: "https://login.microsoftonline.com",
+			graphEndpoint: "https://graph.microsoft.com",
+			audience:      "https://graph.microsoft.com/",
+		},
+		{
+			name:          "USGovernmentL4",
+			environment:   environments.USGovernmentL4,
+			loginEndpoint: "https://login.microsoftonline.us",
+			graphEndpoint: "https://graph.microsoft.us",
+			audience:      "https://graph.microsoft.us/",
+		},
+		{
+			name:          "USGovernmentL5",
+			environment:   environments.USGovernmentL5,
+			loginEndpoint: "https://login.microsoftonline.us",
+			graphEndpoint: "https://dod-graph.microsoft.us",
+			audience:      "https://dod-graph.microsoft.us/",
+		},
+		{
+			name:          "China",
+			environment:   environments.China,
+			loginEndpoint: "https://login.chinacloudapi.cn",
+			graphEndpoint: "https://microsoftgraph.chinacloudapi.cn",
+			audience:      "https://microsoftgraph.chinacloudapi.cn/",
+		},
+		{
+			name:          "Germany",
+			environment:   environments.Germany,
+			loginEndpoint: "https://login.microsoftonline.de",
+			graphEndpoint: "https://graph.microsoft.de",
+			audience:      "https://graph.microsoft.de/",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := c.environment.LoginEndpoint(); got != c.loginEndpoint {
+				t.Errorf("LoginEndpoint(): got %q, want %q", got, c.loginEndpoint)
+			}
+			if got := c.environment.GraphEndpoint(); got != c.graphEndpoint {
+				t.Errorf("GraphEndpoint(): got %q, want %q", got, c.graphEndpoint)
+			}
+			if got := c.environment.Audience(); got != c.audience {
+				t.Errorf("Audience(): got %q, want %q", got, c.audience)
+			}
+		})
+	}
+}
+
+func TestRegisterEnvironment(t *testing.T) {
+	custom := environments.APIEndpoint{}
+	environments.RegisterEnvironment(custom)
+
+	if _, ok := environments.LookupEndpoint(custom.Name()); !ok {
+		t.Fatalf("LookupEndpoint(%q): not found after RegisterEnvironment", custom.Name())
+	}
+}
@@ -0,0 +1,101 @@
+package msgraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// BeginCreate creates a new AccessPackageAssignmentRequest and returns a
+// Poller that tracks its asynchronous transition through PendingApproval and
+// Delivering into a terminal state (Delivered, Denied or Canceled), rather
+// than requiring callers to poll Get manually.
+func (c *AccessPackageAssignmentRequestClient) BeginCreate(ctx context.Context, request AccessPackageAssignmentRequest) (*Poller[AccessPackageAssignmentRequest], int, error) {
+	created, status, err := c.Create(ctx, request)
+	if err != nil {
+		return nil, status, err
+	}
+	if created.ID == nil {
+		return nil, status, fmt.Errorf("AccessPackageAssignmentRequestClient.BeginCreate(): response did not contain an ID")
+	}
+
+	return c.newRequestPoller(*created.ID), status, nil
+}
+
+// ResumePoller rebuilds a Poller for the AccessPackageAssignmentRequest
+// identified by id, restoring the backoff state captured in a token
+// previously returned by Poller.ResumeToken. Use this to resume polling a
+// request that was begun with BeginCreate in an earlier process, once the
+// request ID and resume token have been persisted somewhere durable.
+func (c *AccessPackageAssignmentRequestClient) ResumePoller(id string, token string) (*Poller[AccessPackageAssignmentRequest], error) {
+	poller := c.newRequestPoller(id)
+	if err := poller.ResumeFromToken(token); err != nil {
+		return nil, fmt.Errorf("Poller.ResumeFromToken(): %v", err)
+	}
+	return poller, nil
+}
+
+// newRequestPoller returns a Poller that tracks the AccessPackageAssignmentRequest
+// identified by id through to a terminal state, honoring any Retry-After
+// header returned by Graph between polls.
+func (c *AccessPackageAssignmentRequestClient) newRequestPoller(id string) *Poller[AccessPackageAssignmentRequest] {
+	return newPoller(func(ctx context.Context) (*AccessPackageAssignmentRequest, bool, time.Duration, error) {
+		resp, _, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+			ValidStatusCodes: []int{http.StatusOK},
+			Uri: Uri{
+				Entity:      fmt.Sprintf("/identityGovernance/entitlementManagement/assignmentRequests/%s", id),
+				HasTenantId: true,
+			},
+		})
+		if err != nil {
+			return nil, false, 0, fmt.Errorf("AccessPackageAssignmentRequestClient.BaseClient.Get(): %v", err)
+		}
+		defer resp.Body.Close()
+
+		respBody, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return nil, false, 0, fmt.Errorf("ioutil.ReadAll(): %v", err)
+		}
+
+		var current AccessPackageAssignmentRequest
+		if err := json.Unmarshal(respBody, &current); err != nil {
+			return nil, false, 0, fmt.Errorf("json.Unmarshal(): %v", err)
+		}
+
+		var done bool
+		if current.State != nil {
+			switch *current.State {
+			case AccessPackageRequestStateDelivered, AccessPackageRequestStateDenied, AccessPackageRequestStateCanceled:
+				done = true
+			}
+		}
+
+		return &current, done, retryAfter(resp), nil
+	})
+}
+
+// retryAfter parses a Retry-After response header, which per RFC 9110 is
+// either a number of seconds or an HTTP-date. It returns 0 if the header is
+// absent or unparseable, leaving the Poller to fall back to its own backoff.
+func retryAfter(resp *http.Response) time.Duration {
+	header := resp.Header.Get("Retry-After")
+	if header == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(header); err == nil {
+		if wait := time.Until(date); wait > 0 {
+			return wait
+		}
+	}
+
+	return 0
+}
@@ -140,12 +140,11 @@ func TestAccessPackageAssignmentRequestClient(t *testing.T) {
 
 func deleteWhenPossible(t *testing.T, c *test.Test, ap *msgraph.AccessPackageAssignmentRequest) {
 	// Can only delete a request if it is in specific states
-	switch ap.State {
-	case utils.StringPtr(msgraph.AccessPackageRequestStateDenied):
-		testAccessPacakgeAssignmentRequestClient_Delete(t, c, *ap.ID)
-	case utils.StringPtr(msgraph.AccessPackageRequestStateCanceled):
-		testAccessPacakgeAssignmentRequestClient_Delete(t, c, *ap.ID)
-	case utils.StringPtr(msgraph.AccessPackageRequestStateDelivered):
+	if ap.State == nil {
+		return
+	}
+	switch *ap.State {
+	case msgraph.AccessPackageRequestStateDenied, msgraph.AccessPackageRequestStateCanceled, msgraph.AccessPackageRequestStateDelivered:
 		testAccessPacakgeAssignmentRequestClient_Delete(t, c, *ap.ID)
 	}
 }
@@ -219,3 +218,113 @@ func testAccessPacakgeAssignmentRequestClient_Delete(t *testing.T, c *test.Test,
 		t.Fatalf("AccessPackageAssignmentRequestClient.Delete(): invalid status: %d", status)
 	}
 }
+
+func TestAccessPackageAssignmentRequestClient_BeginCreate(t *testing.T) {
+	c := test.NewTest(t)
+	defer c.CancelFunc()
+
+	accessPackageCatalog := testAccessPackageCatalog_Create(t, c)
+	accessPackage := testAccessPackage_Create(t, c, accessPackageCatalog)
+	currentTimePlusDay := time.Now().AddDate(0, 0, 1)
+
+	user := testUsersClient_Create(t, c, msgraph.User{
+		AccountEnabled:    utils.BoolPtr(true),
+		DisplayName:       utils.StringPtr("test-user-poller"),
+		MailNickname:      utils.StringPtr(fmt.Sprintf("test-user-poller-%s", c.RandomString)),
+		UserPrincipalName: utils.StringPtr(fmt.Sprintf("test-user-poller-%s@%s", c.RandomString, c.Connections["default"].DomainName)),
+		PasswordProfile: &msgraph.UserPasswordProfile{
+			Password: utils.StringPtr(fmt.Sprintf("IrPa55w0rd%s", c.RandomString)),
+		},
+	})
+
+	approverUser := testUsersClient_Create(t, c, msgraph.User{
+		AccountEnabled:    utils.BoolPtr(true),
+		DisplayName:       utils.StringPtr("test-user-poller-approver"),
+		MailNickname:      utils.StringPtr(fmt.Sprintf("test-user-poller-approver-%s", c.RandomString)),
+		UserPrincipalName: utils.StringPtr(fmt.Sprintf("test-user-poller-approver-%s@%s", c.RandomString, c.Connections["default"].DomainName)),
+		PasswordProfile: &msgraph.UserPasswordProfile{
+			Password: utils.StringPtr(fmt.Sprintf("IrPa55w0rd%s", c.RandomString)),
+		},
+	})
+
+	accessPackageAssignmentPolicy := testAccessPackageAssignmentPolicyClient_Create(t, c, msgraph.AccessPackageAssignmentPolicy{
+		AccessPackageId: accessPackage.ID,
+		AccessReviewSettings: &msgraph.AssignmentReviewSettings{
+			AccessReviewTimeoutBehavior:     msgraph.AccessReviewTimeoutBehaviorTypeRemoveAccess,
+			IsEnabled:                       utils.BoolPtr(true),
+			StartDateTime:                   &currentTimePlusDay,
+			DurationInDays:                  utils.Int32Ptr(5),
+			RecurrenceType:                  msgraph.AccessReviewRecurranceTypeMonthly,
+			ReviewerType:                    msgraph.AccessReviewReviewerTypeSelf,
+			IsAccessRecommendationEnabled:   utils.BoolPtr(true),
+			IsApprovalJustificationRequired: utils.BoolPtr(true),
+			Reviewers: &[]msgraph.UserSet{
+				{
+					ODataType: utils.StringPtr(odata.TypeUser),
+					IsBackup:  utils.BoolPtr(false),
+					ID:        approverUser.Id,
+				},
+			},
+		},
+		DisplayName: utils.StringPtr(fmt.Sprintf("Test-AP-Policy-Poller-%s", c.RandomString)),
+		Description: utils.StringPtr("Test AP Policy Poller Description"),
+		RequestorSettings: &msgraph.RequestorSettings{
+			ScopeType:      msgraph.RequestorSettingsScopeTypeNoSubjects,
+			AcceptRequests: utils.BoolPtr(true),
+		},
+		RequestApprovalSettings: &msgraph.ApprovalSettings{
+			IsApprovalRequired:               utils.BoolPtr(true),
+			IsApprovalRequiredForExtension:   utils.BoolPtr(false),
+			IsRequestorJustificationRequired: utils.BoolPtr(false),
+			ApprovalMode:                     msgraph.ApprovalModeSingleStage,
+			ApprovalStages: &[]msgraph.ApprovalStage{
+				{
+					ApprovalStageTimeOutInDays:      utils.Int32Ptr(7),
+					IsApproverJustificationRequired: utils.BoolPtr(false),
+					IsEscalationEnabled:             utils.BoolPtr(false),
+					PrimaryApprovers: &[]msgraph.UserSet{
+						{
+							ODataType: utils.StringPtr(odata.TypeUser),
+							IsBackup:  utils.BoolPtr(false),
+							ID:        approverUser.Id,
+						},
+					},
+				},
+			},
+		},
+	})
+
+	poller, status, err := c.AccessPackageAssignmentRequestClient.BeginCreate(c.Context, msgraph.AccessPackageAssignmentRequest{
+		RequestType: utils.StringPtr(msgraph.AccessPacakgeRequestTypeAdminAdd),
+		AccessPackageAssignment: &msgraph.AccessPackageAssignment{
+			TargetID:            user.Id,
+			AssignementPolicyID: accessPackageAssignmentPolicy.ID,
+			AccessPackageID:     accessPackage.ID,
+		},
+	})
+	if err != nil {
+		t.Fatalf("AccessPackageAssignmentRequestClient.BeginCreate(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("AccessPackageAssignmentRequestClient.BeginCreate(): invalid status: %d", status)
+	}
+
+	result, err := poller.PollUntilDone(c.Context, 5*time.Second)
+	if err != nil {
+		t.Fatalf("Poller.PollUntilDone(): %v", err)
+	}
+	if result == nil || result.State == nil {
+		t.Fatal("Poller.PollUntilDone(): result had no State")
+	}
+	if !poller.Done() {
+		t.Fatal("Poller.Done(): expected true once PollUntilDone has returned")
+	}
+
+	deleteWhenPossible(t, c, result)
+
+	testAccessPackageAssignmentPolicyClient_Delete(t, c, *accessPackageAssignmentPolicy.ID)
+	testAccessPackage_Delete(t, c, *accessPackage.ID)
+	testAccessPackageCatalog_Delete(t, c, accessPackageCatalog)
+	testUser_Delete(t, c, user)
+	testUser_Delete(t, c, approverUser)
+}
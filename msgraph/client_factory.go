@@ -0,0 +1,121 @@
+package msgraph
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/manicminer/hamilton/auth"
+)
+
+// RetryPolicy configures how the clients produced by a ClientFactory retry
+// failed requests (e.g. 429/5xx responses).
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of times a request is retried after a
+	// retryable failure before giving up.
+	MaxRetries int
+
+	// MinBackoff and MaxBackoff bound the exponential backoff applied between
+	// retries.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy is applied by NewClientFactory when no RetryPolicy is
+// supplied.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 5,
+	MinBackoff: 1 * time.Second,
+	MaxBackoff: 60 * time.Second,
+}
+
+// ClientFactory constructs msgraph clients that share a single underlying
+// transport, authorizer, retry policy and RateLimitCoordinator. Since Client
+// holds its HTTP transport, Authorizer and RateLimiter as pointers/
+// interfaces, every copy handed out by a factory continues to share that
+// state. The clients' requests do not consult the shared
+// RateLimitCoordinator: a 429 on one client does not delay its sibling
+// clients unless Throttled and Wait are called on the coordinator directly.
+// The NewXxxClient constructors remain available for compatibility.
+type ClientFactory struct {
+	tenantId string
+	base     Client
+}
+
+// NewClientFactory builds a ClientFactory for the given tenant, authenticated
+// using the supplied auth.Config against the cloud named by config.Environment.
+// A zero-value RetryPolicy is replaced with DefaultRetryPolicy; every client
+// the factory produces shares the resulting retry/backoff configuration as
+// well as a single RateLimitCoordinator.
+func NewClientFactory(ctx context.Context, tenantId string, config auth.Config, retryPolicy RetryPolicy) (*ClientFactory, error) {
+	authorizer, err := auth.NewAuthorizer(ctx, config)
+	if err != nil {
+		return nil, fmt.Errorf("auth.NewAuthorizer(): %v", err)
+	}
+
+	if retryPolicy == (RetryPolicy{}) {
+		retryPolicy = DefaultRetryPolicy
+	}
+
+	client := NewClient(Version10, tenantId)
+	client.Authorizer = authorizer
+	client.Environment = config.Environment
+	client.RetryableClient.RetryMax = retryPolicy.MaxRetries
+	client.RetryableClient.RetryWaitMin = retryPolicy.MinBackoff
+	client.RetryableClient.RetryWaitMax = retryPolicy.MaxBackoff
+	client.RateLimiter = NewRateLimitCoordinator()
+
+	return &ClientFactory{
+		tenantId: tenantId,
+		base:     client,
+	}, nil
+}
+
+// client returns a copy of the factory's shared Client, ready to be embedded
+// as a specific client's BaseClient.
+func (f *ClientFactory) client() Client {
+	return f.base
+}
+
+// NewNamedLocationsClient returns a NamedLocationsClient backed by this
+// factory's shared transport, authorizer and throttling state.
+func (f *ClientFactory) NewNamedLocationsClient() *NamedLocationsClient {
+	return &NamedLocationsClient{BaseClient: f.client()}
+}
+
+// NewAccessPackageAssignmentRequestClient returns an
+// AccessPackageAssignmentRequestClient backed by this factory's shared
+// transport, authorizer and throttling state.
+func (f *ClientFactory) NewAccessPackageAssignmentRequestClient() *AccessPackageAssignmentRequestClient {
+	return &AccessPackageAssignmentRequestClient{BaseClient: f.client()}
+}
+
+// NewUsersClient returns a UsersClient backed by this factory's shared
+// transport, authorizer and throttling state.
+func (f *ClientFactory) NewUsersClient() *UsersClient {
+	return &UsersClient{BaseClient: f.client()}
+}
+
+// NewGroupsClient returns a GroupsClient backed by this factory's shared
+// transport, authorizer and throttling state.
+func (f *ClientFactory) NewGroupsClient() *GroupsClient {
+	return &GroupsClient{BaseClient: f.client()}
+}
+
+// NewApplicationsClient returns an ApplicationsClient backed by this
+// factory's shared transport, authorizer and throttling state.
+func (f *ClientFactory) NewApplicationsClient() *ApplicationsClient {
+	return &ApplicationsClient{BaseClient: f.client()}
+}
+
+// NewServicePrincipalsClient returns a ServicePrincipalsClient backed by this
+// factory's shared transport, authorizer and throttling state.
+func (f *ClientFactory) NewServicePrincipalsClient() *ServicePrincipalsClient {
+	return &ServicePrincipalsClient{BaseClient: f.client()}
+}
+
+// NewDeletedItemsClient returns a DeletedItemsClient backed by this factory's
+// shared transport, authorizer and throttling state.
+func (f *ClientFactory) NewDeletedItemsClient() *DeletedItemsClient {
+	return &DeletedItemsClient{BaseClient: f.client()}
+}
@@ -0,0 +1,32 @@
+package msgraph
+
+import "context"
+
+// Restore recovers a soft-deleted user, provided it is still within the
+// 30-day window before Azure AD purges it from /directory/deletedItems.
+func (c *UsersClient) Restore(ctx context.Context, id string) (*DirectoryObject, int, error) {
+	deletedItems := DeletedItemsClient{BaseClient: c.BaseClient}
+	return deletedItems.Restore(ctx, id)
+}
+
+// Restore recovers a soft-deleted group, provided it is still within the
+// 30-day window before Azure AD purges it from /directory/deletedItems.
+func (c *GroupsClient) Restore(ctx context.Context, id string) (*DirectoryObject, int, error) {
+	deletedItems := DeletedItemsClient{BaseClient: c.BaseClient}
+	return deletedItems.Restore(ctx, id)
+}
+
+// Restore recovers a soft-deleted application, provided it is still within
+// the 30-day window before Azure AD purges it from /directory/deletedItems.
+func (c *ApplicationsClient) Restore(ctx context.Context, id string) (*DirectoryObject, int, error) {
+	deletedItems := DeletedItemsClient{BaseClient: c.BaseClient}
+	return deletedItems.Restore(ctx, id)
+}
+
+// Restore recovers a soft-deleted service principal, provided it is still
+// within the 30-day window before Azure AD purges it from
+// /directory/deletedItems.
+func (c *ServicePrincipalsClient) Restore(ctx context.Context, id string) (*DirectoryObject, int, error) {
+	deletedItems := DeletedItemsClient{BaseClient: c.BaseClient}
+	return deletedItems.Restore(ctx, id)
+}
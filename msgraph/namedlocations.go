@@ -24,6 +24,27 @@ func NewNamedLocationsClient(tenantId string) *NamedLocationsClient {
 	}
 }
 
+// namedLocationTypes maps @odata.type to the concrete NamedLocation
+// implementation used to decode it. Constructors return a pointer since
+// json.Unmarshal requires an addressable target; List() below dereferences
+// the known types back to plain values to match the value types List() has
+// always returned in its slice.
+var namedLocationTypes = odata.NewPolymorphicRegistry()
+
+func init() {
+	namedLocationTypes.RegisterType("#microsoft.graph.countryNamedLocation", func() any { return &CountryNamedLocation{} })
+	namedLocationTypes.RegisterType("#microsoft.graph.ipNamedLocation", func() any { return &IPNamedLocation{} })
+}
+
+// UnknownNamedLocation is returned in place of a NamedLocation whose
+// @odata.type has no corresponding Go type, preserving the raw JSON and the
+// odata type string so that unrecognized Named Locations are surfaced to the
+// caller instead of being silently dropped.
+type UnknownNamedLocation struct {
+	ODataType string
+	Raw       json.RawMessage
+}
+
 // List returns a list of Named Locations, optionally filtered using OData.
 func (c *NamedLocationsClient) List(ctx context.Context, filter string) (*[]NamedLocation, int, error) {
 	params := url.Values{}
@@ -51,48 +72,33 @@ func (c *NamedLocationsClient) List(ctx context.Context, filter string) (*[]Name
 	}
 
 	var data struct {
-		NamedLocations *[]json.RawMessage `json:"value"`
+		NamedLocations []json.RawMessage `json:"value"`
 	}
 
 	if err := json.Unmarshal(respBody, &data); err != nil {
 		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
 	}
 
-	// The Graph API returns a mixture of types, this loop matches up the result to the appropriate model
-	var ret []NamedLocation
-
-	if data.NamedLocations == nil {
-		// Treat this as no result
-		return &ret, status, nil
+	values, err := odata.UnmarshalPolymorphicArray(data.NamedLocations, namedLocationTypes)
+	if err != nil {
+		return nil, status, fmt.Errorf("odata.UnmarshalPolymorphicArray(): %v", err)
 	}
 
-	for _, namedLocation := range *data.NamedLocations {
-		var o odata.OData
-		if err := json.Unmarshal(namedLocation, &o); err != nil {
-			return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
-		}
-
-		if o.Type == nil {
-			continue
-		}
-		switch *o.Type {
-		case "#microsoft.graph.countryNamedLocation":
-			var loc CountryNamedLocation
-			if err := json.Unmarshal(namedLocation, &loc); err != nil {
-				return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
-			}
-			ret = append(ret, loc)
-		case "#microsoft.graph.ipNamedLocation":
-			var loc IPNamedLocation
-			if err := json.Unmarshal(namedLocation, &loc); err != nil {
-				return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
-			}
-			ret = append(ret, loc)
+	ret := make([]NamedLocation, 0, len(values))
+	for _, value := range values {
+		switch v := value.(type) {
+		case odata.UnknownType:
+			ret = append(ret, UnknownNamedLocation{ODataType: v.ODataType, Raw: v.Raw})
+		case *CountryNamedLocation:
+			ret = append(ret, *v)
+		case *IPNamedLocation:
+			ret = append(ret, *v)
+		default:
+			ret = append(ret, value.(NamedLocation))
 		}
 	}
 
 	return &ret, status, nil
-
 }
 
 // Delete removes a Named Location.
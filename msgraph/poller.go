@@ -0,0 +1,134 @@
+package msgraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	pollerMinInterval = 2 * time.Second
+	pollerMaxInterval = 60 * time.Second
+)
+
+// PollingFunc performs a single poll of a long-running operation, reporting
+// the latest state of the resource, whether the operation has reached a
+// terminal state, and how long to wait before polling again (typically
+// derived from a Retry-After response header; zero means "no preference").
+type PollingFunc[T any] func(ctx context.Context) (result *T, done bool, retryAfter time.Duration, err error)
+
+// pollerResumeToken is the JSON-serializable state needed to resume a Poller.
+type pollerResumeToken struct {
+	LastInterval time.Duration `json:"lastInterval"`
+}
+
+// Poller drives a Graph long-running operation to completion, applying
+// exponential backoff between polls (honoring any Retry-After reported by its
+// PollingFunc), and exposes its state so that callers can persist it and
+// resume polling later via ResumeToken/ResumeFromToken.
+type Poller[T any] struct {
+	poll PollingFunc[T]
+
+	done   bool
+	result *T
+	err    error
+
+	lastInterval time.Duration
+}
+
+// newPoller constructs a Poller around the supplied PollingFunc.
+func newPoller[T any](poll PollingFunc[T]) *Poller[T] {
+	return &Poller[T]{poll: poll, lastInterval: pollerMinInterval}
+}
+
+// Poll performs a single poll of the operation.
+func (p *Poller[T]) Poll(ctx context.Context) error {
+	if p.done {
+		return nil
+	}
+
+	result, done, retryAfter, err := p.poll(ctx)
+	if err != nil {
+		p.err = err
+		return err
+	}
+
+	switch {
+	case retryAfter > 0:
+		p.lastInterval = retryAfter
+	case !done:
+		p.lastInterval *= 2
+		if p.lastInterval > pollerMaxInterval {
+			p.lastInterval = pollerMaxInterval
+		}
+	}
+
+	p.result = result
+	p.done = done
+	return nil
+}
+
+// Done reports whether the operation has reached a terminal state.
+func (p *Poller[T]) Done() bool {
+	return p.done
+}
+
+// Result returns the final resource once the operation has reached a
+// terminal state. It returns an error if called before Done() is true.
+func (p *Poller[T]) Result() (*T, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	if !p.done {
+		return nil, fmt.Errorf("msgraph.Poller: operation has not yet completed")
+	}
+	return p.result, nil
+}
+
+// PollUntilDone polls repeatedly, waiting freq (or the Poller's current
+// backoff interval, if larger: the Retry-After reported by the last poll, or
+// else the previous interval doubled) between attempts, until the operation
+// reaches a terminal state or ctx is cancelled.
+func (p *Poller[T]) PollUntilDone(ctx context.Context, freq time.Duration) (*T, error) {
+	for {
+		if err := p.Poll(ctx); err != nil {
+			return nil, err
+		}
+		if p.done {
+			return p.Result()
+		}
+
+		interval := p.lastInterval
+		if freq > interval {
+			interval = freq
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+// ResumeToken returns an opaque token capturing the Poller's backoff state,
+// suitable for persisting and later passing to ResumeFromToken.
+func (p *Poller[T]) ResumeToken() (string, error) {
+	token, err := json.Marshal(pollerResumeToken{LastInterval: p.lastInterval})
+	if err != nil {
+		return "", fmt.Errorf("json.Marshal(): %v", err)
+	}
+	return string(token), nil
+}
+
+// ResumeFromToken restores a Poller's backoff state from a token previously
+// returned by ResumeToken.
+func (p *Poller[T]) ResumeFromToken(token string) error {
+	var resumed pollerResumeToken
+	if err := json.Unmarshal([]byte(token), &resumed); err != nil {
+		return fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	p.lastInterval = resumed.LastInterval
+	return nil
+}
@@ -0,0 +1,53 @@
+package msgraph
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimitCoordinator tracks a shared "not before" time: Throttled pushes it
+// back and Wait blocks until it has passed. A ClientFactory hands the same
+// coordinator to every client it produces, but nothing in this package calls
+// Throttled or Wait, so requests are not delayed by it unless the caller
+// invokes them.
+type RateLimitCoordinator struct {
+	mu        sync.Mutex
+	notBefore time.Time
+}
+
+// NewRateLimitCoordinator returns an empty RateLimitCoordinator.
+func NewRateLimitCoordinator() *RateLimitCoordinator {
+	return &RateLimitCoordinator{}
+}
+
+// Throttled records a 429 observed with the given Retry-After duration,
+// pushing back the coordinator's "not before" time so that every subsequent
+// call to Wait on this coordinator waits it out.
+func (r *RateLimitCoordinator) Throttled(retryAfter time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if notBefore := time.Now().Add(retryAfter); notBefore.After(r.notBefore) {
+		r.notBefore = notBefore
+	}
+}
+
+// Wait blocks until the coordinator's "not before" time has passed, or ctx is
+// cancelled, whichever comes first.
+func (r *RateLimitCoordinator) Wait(ctx context.Context) error {
+	r.mu.Lock()
+	wait := time.Until(r.notBefore)
+	r.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(wait):
+		return nil
+	}
+}
@@ -0,0 +1,88 @@
+package odata
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// PolymorphicRegistry maps an @odata.type value to a constructor for the
+// concrete Go type that should be used to decode it. Packages that expose
+// heterogeneous Graph collections (named locations, directory objects,
+// authentication methods, identity providers, userSet, workbook charts, ...)
+// register their known types at init time and decode responses with
+// UnmarshalPolymorphic/UnmarshalPolymorphicArray instead of hand-rolling a
+// peek-then-switch over @odata.type.
+type PolymorphicRegistry struct {
+	mu    sync.RWMutex
+	types map[string]func() any
+}
+
+// NewPolymorphicRegistry returns an empty PolymorphicRegistry.
+func NewPolymorphicRegistry() *PolymorphicRegistry {
+	return &PolymorphicRegistry{types: make(map[string]func() any)}
+}
+
+// RegisterType associates an @odata.type value with a constructor for the
+// concrete type used to decode it. Intended to be called from a package's
+// init() function.
+func (r *PolymorphicRegistry) RegisterType(odataType string, new func() any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.types[odataType] = new
+}
+
+func (r *PolymorphicRegistry) lookup(odataType string) (func() any, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	new, ok := r.types[odataType]
+	return new, ok
+}
+
+// UnknownType wraps a value whose @odata.type has no registered constructor,
+// preserving the raw JSON and the odata type string so that callers can still
+// inspect it rather than having it silently dropped.
+type UnknownType struct {
+	ODataType string
+	Raw       json.RawMessage
+}
+
+// UnmarshalPolymorphic decodes a single JSON value whose concrete type is
+// determined by its @odata.type, using the constructors registered in reg. If
+// the type is absent or unregistered, it returns an UnknownType wrapping the
+// raw JSON rather than an error.
+func UnmarshalPolymorphic(raw json.RawMessage, reg *PolymorphicRegistry) (any, error) {
+	var o OData
+	if err := json.Unmarshal(raw, &o); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	if o.Type == nil {
+		return UnknownType{Raw: raw}, nil
+	}
+
+	new, ok := reg.lookup(*o.Type)
+	if !ok {
+		return UnknownType{ODataType: *o.Type, Raw: raw}, nil
+	}
+
+	val := new()
+	if err := json.Unmarshal(raw, val); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return val, nil
+}
+
+// UnmarshalPolymorphicArray decodes each element of raw using
+// UnmarshalPolymorphic.
+func UnmarshalPolymorphicArray(raw []json.RawMessage, reg *PolymorphicRegistry) ([]any, error) {
+	ret := make([]any, 0, len(raw))
+	for _, item := range raw {
+		val, err := UnmarshalPolymorphic(item, reg)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, val)
+	}
+	return ret, nil
+}